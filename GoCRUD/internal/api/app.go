@@ -0,0 +1,55 @@
+// Package api exposes the HTTP surface of the application as methods on
+// App, so it can be wired up in cmd/server and exercised directly in
+// tests via httptest.
+package api
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"gorm.io/gorm"
+
+	"github.com/dinesh00509/GoCRUD/internal/config"
+)
+
+// App holds the dependencies shared by every handler.
+type App struct {
+	DB     *gorm.DB
+	Cfg    *config.Config
+	Logger *slog.Logger
+}
+
+// NewApp wires up an App with its dependencies.
+func NewApp(db *gorm.DB, cfg *config.Config, logger *slog.Logger) *App {
+	return &App{DB: db, Cfg: cfg, Logger: logger}
+}
+
+// Routes builds the full HTTP routing tree for the application.
+func (a *App) Routes() http.Handler {
+	r := chi.NewRouter()
+
+	r.Use(middleware.RequestID)
+	r.Use(middleware.Logger)
+	r.Use(middleware.Recoverer)
+	r.Use(middleware.Timeout(30 * time.Second))
+
+	r.Post("/register", a.Register)
+	r.Post("/login", a.Login)
+
+	r.Route("/users", func(r chi.Router) {
+		r.Use(a.AuthMiddleware)
+		r.Get("/", a.FetchUsers)
+		r.Post("/", a.CreateUser)
+
+		r.Route("/{id}", func(r chi.Router) {
+			r.Get("/", a.FetchUser)
+			r.Put("/", a.UpdateUser)
+			r.Delete("/", a.DeleteUser)
+		})
+	})
+
+	return r
+}
@@ -0,0 +1,138 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/render"
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/dinesh00509/GoCRUD/internal/models"
+	"github.com/dinesh00509/GoCRUD/internal/validate"
+)
+
+type ctxKey int
+
+// UserKey is the context key AuthMiddleware stores the caller's email under.
+const UserKey ctxKey = iota
+
+// Register verifies the request body, hashes the password and creates a
+// new Registration record.
+func (a *App) Register(w http.ResponseWriter, r *http.Request) {
+	var req RegisterRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderError(w, r, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if fieldErrs := validate.Struct(req); fieldErrs != nil {
+		renderValidationError(w, r, fieldErrs)
+		return
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		renderError(w, r, http.StatusInternalServerError, "Failed to hash password")
+		return
+	}
+
+	registration := models.Registration{
+		Email:    strings.ToLower(req.Email),
+		Password: string(hashedPassword),
+	}
+	if result := a.DB.Create(&registration); result.Error != nil {
+		renderError(w, r, http.StatusInternalServerError, "Failed to create user")
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, newRegistrationResponse(registration))
+}
+
+// Login verifies an email/password pair against the Registration table
+// and, on success, issues a signed JWT that the caller must present as a
+// Bearer token on subsequent requests to protected routes.
+func (a *App) Login(w http.ResponseWriter, r *http.Request) {
+	var req LoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderError(w, r, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if fieldErrs := validate.Struct(req); fieldErrs != nil {
+		renderValidationError(w, r, fieldErrs)
+		return
+	}
+
+	var registration models.Registration
+	if result := a.DB.Where("email = ?", strings.ToLower(req.Email)).First(&registration); result.Error != nil {
+		renderError(w, r, http.StatusUnauthorized, "Invalid email or password")
+		return
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(registration.Password), []byte(req.Password)); err != nil {
+		renderError(w, r, http.StatusUnauthorized, "Invalid email or password")
+		return
+	}
+
+	expiresIn := 72 * time.Hour
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub": registration.Email,
+		"exp": time.Now().Add(expiresIn).Unix(),
+	})
+
+	signed, err := token.SignedString([]byte(a.Cfg.HashingSecret))
+	if err != nil {
+		renderError(w, r, http.StatusInternalServerError, "Failed to sign token")
+		return
+	}
+
+	render.JSON(w, r, map[string]interface{}{
+		"access_token": signed,
+		"expires_in":   int(expiresIn.Seconds()),
+	})
+}
+
+// AuthMiddleware parses and validates the Authorization: Bearer <token>
+// header on incoming requests, rejecting unauthenticated callers with a
+// 401 and otherwise injecting the token's subject (email) into the
+// request context under UserKey.
+func (a *App) AuthMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authHeader := r.Header.Get("Authorization")
+		if authHeader == "" {
+			renderError(w, r, http.StatusUnauthorized, "Missing Authorization header")
+			return
+		}
+
+		parts := strings.SplitN(authHeader, " ", 2)
+		if len(parts) != 2 || parts[0] != "Bearer" {
+			renderError(w, r, http.StatusUnauthorized, "Invalid Authorization header")
+			return
+		}
+
+		token, err := jwt.Parse(parts[1], func(t *jwt.Token) (interface{}, error) {
+			if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+				return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+			}
+			return []byte(a.Cfg.HashingSecret), nil
+		})
+		if err != nil || !token.Valid {
+			renderError(w, r, http.StatusUnauthorized, "Invalid or expired token")
+			return
+		}
+
+		claims, ok := token.Claims.(jwt.MapClaims)
+		if !ok {
+			renderError(w, r, http.StatusUnauthorized, "Invalid token claims")
+			return
+		}
+
+		email, _ := claims["sub"].(string)
+		ctx := context.WithValue(r.Context(), UserKey, email)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
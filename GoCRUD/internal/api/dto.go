@@ -0,0 +1,51 @@
+package api
+
+import "github.com/dinesh00509/GoCRUD/internal/models"
+
+// RegisterRequest is the payload for POST /register.
+type RegisterRequest struct {
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required,min=10"`
+}
+
+// LoginRequest is the payload for POST /login.
+type LoginRequest struct {
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required"`
+}
+
+// CreateUserRequest is the payload for POST /users and PUT /users/{id}.
+type CreateUserRequest struct {
+	Name  string `json:"name" validate:"required,min=1,max=100"`
+	Email string `json:"email" validate:"required,email"`
+}
+
+// RegistrationResponse is what POST /register returns. It deliberately
+// has no Password field so the bcrypt hash never reaches a client.
+type RegistrationResponse struct {
+	ID    uint   `json:"id"`
+	Email string `json:"email"`
+}
+
+func newRegistrationResponse(r models.Registration) RegistrationResponse {
+	return RegistrationResponse{ID: r.ID, Email: r.Email}
+}
+
+// UserResponse is what the /users endpoints return.
+type UserResponse struct {
+	ID    uint   `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+func newUserResponse(u models.User) UserResponse {
+	return UserResponse{ID: u.ID, Name: u.Name, Email: u.Email}
+}
+
+func newUserResponses(users []models.User) []UserResponse {
+	responses := make([]UserResponse, len(users))
+	for i, u := range users {
+		responses[i] = newUserResponse(u)
+	}
+	return responses
+}
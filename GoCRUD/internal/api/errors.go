@@ -0,0 +1,55 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+
+	"github.com/dinesh00509/GoCRUD/internal/validate"
+)
+
+// ErrResponse is the structured JSON body returned for every handler
+// error, so clients never have to deal with text/plain error strings.
+type ErrResponse struct {
+	HTTPStatusCode int    `json:"status"`
+	Error          string `json:"error"`
+	RequestID      string `json:"request_id,omitempty"`
+}
+
+func (e *ErrResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, e.HTTPStatusCode)
+	return nil
+}
+
+func renderError(w http.ResponseWriter, r *http.Request, status int, msg string) {
+	render.Render(w, r, &ErrResponse{
+		HTTPStatusCode: status,
+		Error:          msg,
+		RequestID:      middleware.GetReqID(r.Context()),
+	})
+}
+
+// ValidationErrResponse is returned when request DTO validation fails; it
+// carries the individual field errors alongside the usual status/error
+// envelope.
+type ValidationErrResponse struct {
+	HTTPStatusCode int                   `json:"status"`
+	Error          string                `json:"error"`
+	RequestID      string                `json:"request_id,omitempty"`
+	Fields         []validate.FieldError `json:"fields"`
+}
+
+func (e *ValidationErrResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	render.Status(r, e.HTTPStatusCode)
+	return nil
+}
+
+func renderValidationError(w http.ResponseWriter, r *http.Request, fieldErrs []validate.FieldError) {
+	render.Render(w, r, &ValidationErrResponse{
+		HTTPStatusCode: http.StatusUnprocessableEntity,
+		Error:          "Validation failed",
+		RequestID:      middleware.GetReqID(r.Context()),
+		Fields:         fieldErrs,
+	})
+}
@@ -0,0 +1,167 @@
+package api
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/render"
+	"gorm.io/gorm"
+
+	"github.com/dinesh00509/GoCRUD/internal/models"
+)
+
+const (
+	defaultLimit = 25
+	maxLimit     = 100
+)
+
+var sortColumns = map[string]bool{
+	"id":    true,
+	"name":  true,
+	"email": true,
+}
+
+// UsersPage is the response body for GET /users.
+type UsersPage struct {
+	Data       []UserResponse `json:"data"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+	Total      int64          `json:"total"`
+}
+
+// usersListQuery holds the parsed, validated query parameters accepted by
+// GET /users.
+type usersListQuery struct {
+	limit  int
+	offset int
+	cursor uint
+	sort   string
+	order  string
+	q      string
+}
+
+func parseUsersListQuery(r *http.Request) usersListQuery {
+	q := r.URL.Query()
+
+	limit := defaultLimit
+	if v, err := strconv.Atoi(q.Get("limit")); err == nil && v > 0 {
+		limit = v
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+
+	var offset int
+	if v, err := strconv.Atoi(q.Get("offset")); err == nil && v > 0 {
+		offset = v
+	}
+
+	sort := q.Get("sort")
+	if !sortColumns[sort] {
+		sort = "id"
+	}
+
+	// Keyset pagination (?cursor=) is keyed on id, so it's only
+	// consistent with the row order when sorting by id. For any other
+	// sort column, fall back to ?offset= instead of silently skipping
+	// or duplicating rows.
+	var cursor uint
+	if sort == "id" {
+		if raw := q.Get("cursor"); raw != "" {
+			if decoded, err := base64.URLEncoding.DecodeString(raw); err == nil {
+				if id, err := strconv.ParseUint(string(decoded), 10, 64); err == nil {
+					cursor = uint(id)
+				}
+			}
+		}
+	}
+
+	order := q.Get("order")
+	if order != "asc" && order != "desc" {
+		order = "asc"
+	}
+
+	return usersListQuery{
+		limit:  limit,
+		offset: offset,
+		cursor: cursor,
+		sort:   sort,
+		order:  order,
+		q:      q.Get("q"),
+	}
+}
+
+func encodeCursor(id uint) string {
+	return base64.URLEncoding.EncodeToString([]byte(strconv.FormatUint(uint64(id), 10)))
+}
+
+// withSearch applies the ?q= filter on name/email. It's written as
+// LOWER(...) LIKE ? with a pattern lowercased in Go, rather than ILIKE, so
+// it works against both the
+// Postgres backend this API runs on and the SQLite backend used in tests,
+// since ILIKE is Postgres-only.
+func withSearch(query *gorm.DB, q string) *gorm.DB {
+	if q == "" {
+		return query
+	}
+	pattern := "%" + strings.ToLower(q) + "%"
+	return query.Where("LOWER(name) LIKE ? OR LOWER(email) LIKE ?", pattern, pattern)
+}
+
+// FetchUsers returns a page of Users, supporting keyset pagination via
+// ?cursor=, sorting via ?sort=/?order=, and a case-insensitive name/email
+// search via ?q=.
+func (a *App) FetchUsers(w http.ResponseWriter, r *http.Request) {
+	params := parseUsersListQuery(r)
+
+	var total int64
+	if result := withSearch(a.DB.Model(&models.User{}), params.q).Count(&total); result.Error != nil {
+		renderError(w, r, http.StatusInternalServerError, "Failed to count users")
+		return
+	}
+
+	query := withSearch(a.DB.Model(&models.User{}), params.q)
+	if params.cursor != 0 {
+		if params.order == "desc" {
+			query = query.Where("id < ?", params.cursor)
+		} else {
+			query = query.Where("id > ?", params.cursor)
+		}
+	} else if params.offset > 0 {
+		query = query.Offset(params.offset)
+	}
+
+	var users []models.User
+	result := query.
+		Order(fmt.Sprintf("%s %s", params.sort, params.order)).
+		Limit(params.limit + 1).
+		Find(&users)
+	if result.Error != nil {
+		renderError(w, r, http.StatusInternalServerError, "Failed to fetch users")
+		return
+	}
+
+	var nextCursor string
+	if len(users) > params.limit {
+		users = users[:params.limit]
+
+		next := *r.URL
+		q := next.Query()
+		if params.sort == "id" {
+			nextCursor = encodeCursor(users[len(users)-1].ID)
+			q.Set("cursor", nextCursor)
+		} else {
+			q.Set("offset", strconv.Itoa(params.offset+params.limit))
+		}
+		next.RawQuery = q.Encode()
+		w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="next"`, next.String()))
+	}
+
+	render.JSON(w, r, UsersPage{
+		Data:       newUserResponses(users),
+		NextCursor: nextCursor,
+		Total:      total,
+	})
+}
@@ -0,0 +1,111 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"gorm.io/gorm"
+
+	"github.com/dinesh00509/GoCRUD/internal/models"
+	"github.com/dinesh00509/GoCRUD/internal/validate"
+)
+
+// CreateUser decodes and validates a CreateUserRequest from the request
+// body and persists it as a User with a lowercased email.
+func (a *App) CreateUser(w http.ResponseWriter, r *http.Request) {
+	var req CreateUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderError(w, r, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if fieldErrs := validate.Struct(req); fieldErrs != nil {
+		renderValidationError(w, r, fieldErrs)
+		return
+	}
+
+	user := models.User{Name: req.Name, Email: strings.ToLower(req.Email)}
+	if result := a.DB.Create(&user); result.Error != nil {
+		renderError(w, r, http.StatusInternalServerError, "Failed to create user")
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, newUserResponse(user))
+}
+
+// FetchUser returns the User identified by the {id} URL param.
+func (a *App) FetchUser(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	var user models.User
+	result := a.DB.First(&user, id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			renderError(w, r, http.StatusNotFound, "User not found")
+		} else {
+			renderError(w, r, http.StatusInternalServerError, "Failed to fetch user")
+		}
+		return
+	}
+
+	render.JSON(w, r, newUserResponse(user))
+}
+
+// UpdateUser overwrites the Name/Email of the User identified by the {id}
+// URL param.
+func (a *App) UpdateUser(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	var user models.User
+	result := a.DB.First(&user, id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			renderError(w, r, http.StatusNotFound, "User not found")
+		} else {
+			renderError(w, r, http.StatusInternalServerError, "Failed to fetch user")
+		}
+		return
+	}
+
+	var req CreateUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderError(w, r, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if fieldErrs := validate.Struct(req); fieldErrs != nil {
+		renderValidationError(w, r, fieldErrs)
+		return
+	}
+
+	user.Name = req.Name
+	user.Email = strings.ToLower(req.Email)
+
+	if result := a.DB.Save(&user); result.Error != nil {
+		renderError(w, r, http.StatusInternalServerError, "Failed to update user")
+		return
+	}
+
+	render.JSON(w, r, newUserResponse(user))
+}
+
+// DeleteUser removes the User identified by the {id} URL param.
+func (a *App) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	var user models.User
+	result := a.DB.Delete(&user, id)
+	if result.Error != nil {
+		renderError(w, r, http.StatusInternalServerError, "Failed to delete user")
+		return
+	}
+
+	if result.RowsAffected == 0 {
+		renderError(w, r, http.StatusNotFound, "User not found")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
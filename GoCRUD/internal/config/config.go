@@ -0,0 +1,78 @@
+// Package config loads and validates the application's YAML configuration.
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DBConfig holds the connection parameters for the Postgres database.
+type DBConfig struct {
+	Host     string `yaml:"host"`
+	Port     string `yaml:"port"`
+	User     string `yaml:"user"`
+	Password string `yaml:"password"`
+	Name     string `yaml:"name"`
+	SSLMode  string `yaml:"sslmode"`
+}
+
+// Config is the typed representation of config.yaml.
+type Config struct {
+	Server        string   `yaml:"server"`
+	Port          string   `yaml:"port"`
+	HashingSecret string   `yaml:"hashing_secret"`
+	DB            DBConfig `yaml:"db"`
+	TestDB        string   `yaml:"test_db"`
+}
+
+// Error reports a problem with the configuration, naming the field that
+// caused it.
+type Error struct {
+	Field string
+	Msg   string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("config: %s: %s", e.Field, e.Msg)
+}
+
+// Load reads the YAML config file at path, unmarshals it into a Config and
+// validates that all required fields are present.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("config: failed to read %s: %w", path, err)
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("config: failed to parse %s: %w", path, err)
+	}
+
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+
+	return &cfg, nil
+}
+
+func (c *Config) validate() error {
+	if c.Port == "" {
+		return &Error{Field: "port", Msg: "must not be empty"}
+	}
+	if c.HashingSecret == "" {
+		return &Error{Field: "hashing_secret", Msg: "must not be empty"}
+	}
+	if c.DB.Host == "" {
+		return &Error{Field: "db.host", Msg: "must not be empty"}
+	}
+	if c.DB.User == "" {
+		return &Error{Field: "db.user", Msg: "must not be empty"}
+	}
+	if c.DB.Name == "" {
+		return &Error{Field: "db.name", Msg: "must not be empty"}
+	}
+	return nil
+}
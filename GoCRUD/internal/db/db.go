@@ -0,0 +1,29 @@
+// Package db opens the application's Postgres connection.
+package db
+
+import (
+	"fmt"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/dinesh00509/GoCRUD/internal/config"
+)
+
+// Open connects to Postgres using the parameters in cfg.
+func Open(cfg *config.Config) (*gorm.DB, error) {
+	dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
+		cfg.DB.Host,
+		cfg.DB.Port,
+		cfg.DB.User,
+		cfg.DB.Password,
+		cfg.DB.Name,
+		cfg.DB.SSLMode)
+
+	gdb, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("db: failed to connect: %w", err)
+	}
+
+	return gdb, nil
+}
@@ -0,0 +1,23 @@
+// Package models contains the GORM-backed domain types shared by the API.
+package models
+
+import "gorm.io/gorm"
+
+// User is a registered application user.
+type User struct {
+	ID    uint   `json:"id" gorm:"primaryKey"`
+	Name  string `json:"name"`
+	Email string `json:"email" gorm:"unique"`
+}
+
+// Registration holds the credentials used to authenticate a User.
+type Registration struct {
+	ID       uint   `json:"id" gorm:"primaryKey"`
+	Email    string `json:"email" gorm:"unique"`
+	Password string `json:"password" gorm:"unique"`
+}
+
+// Migrate auto-migrates all domain models against db.
+func Migrate(db *gorm.DB) error {
+	return db.AutoMigrate(&User{}, &Registration{})
+}
@@ -0,0 +1,41 @@
+// Package validate wraps github.com/go-playground/validator/v10 so
+// handlers can validate request DTOs and get back a flat, JSON-friendly
+// list of field errors instead of the raw validator error type.
+package validate
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var instance = validator.New()
+
+// FieldError describes a single failed validation rule.
+type FieldError struct {
+	Field string `json:"field"`
+	Error string `json:"error"`
+}
+
+// Struct validates v against its `validate` struct tags and returns the
+// list of field errors, or nil if v is valid.
+func Struct(v interface{}) []FieldError {
+	err := instance.Struct(v)
+	if err == nil {
+		return nil
+	}
+
+	validationErrs, ok := err.(validator.ValidationErrors)
+	if !ok {
+		return []FieldError{{Error: err.Error()}}
+	}
+
+	fieldErrs := make([]FieldError, 0, len(validationErrs))
+	for _, fe := range validationErrs {
+		fieldErrs = append(fieldErrs, FieldError{
+			Field: fe.Field(),
+			Error: fmt.Sprintf("failed on the '%s' tag", fe.Tag()),
+		})
+	}
+	return fieldErrs
+}